@@ -0,0 +1,15 @@
+resource "proxmox_virtual_environment_network_interface" "example" {
+  node_name = data.proxmox_virtual_environment_nodes.example.names[0]
+  iface     = "vmbr99"
+  type      = "bridge"
+  cidr      = "10.99.0.1/24"
+  comments  = "Managed by Terraform"
+}
+
+output "resource_proxmox_virtual_environment_network_interface_example_address" {
+  value = proxmox_virtual_environment_network_interface.example.address
+}
+
+output "resource_proxmox_virtual_environment_network_interface_example_netmask" {
+  value = proxmox_virtual_environment_network_interface.example.netmask
+}
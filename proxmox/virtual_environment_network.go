@@ -11,6 +11,32 @@ import (
 	"sort"
 )
 
+// CreateNetwork creates a network interface.
+func (c *VirtualEnvironmentClient) CreateNetwork(nodeName string, d *VirtualEnvironmentNetworkCreateRequestBody) error {
+	return c.DoRequest(hmPOST, fmt.Sprintf("nodes/%s/network", url.PathEscape(nodeName)), d, nil)
+}
+
+// DeleteNetwork deletes a network interface.
+func (c *VirtualEnvironmentClient) DeleteNetwork(nodeName string, iface string) error {
+	return c.DoRequest(hmDELETE, fmt.Sprintf("nodes/%s/network/%s", url.PathEscape(nodeName), url.PathEscape(iface)), nil, nil)
+}
+
+// GetNetwork retrieves the configuration of a network interface.
+func (c *VirtualEnvironmentClient) GetNetwork(nodeName string, iface string) (*VirtualEnvironmentNetworkGetResponseData, error) {
+	resBody := &VirtualEnvironmentNetworkGetResponseBody{}
+	err := c.DoRequest(hmGET, fmt.Sprintf("nodes/%s/network/%s", url.PathEscape(nodeName), url.PathEscape(iface)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
 // ListNetworks retrieves a list of networks.
 func (c *VirtualEnvironmentClient) ListNetworks(nodeName string, d *VirtualEnvironmentNetworkListRequestBody) ([]*VirtualEnvironmentNetworkListResponseData, error) {
 	resBody := &VirtualEnvironmentNetworkListResponseBody{}
@@ -30,3 +56,41 @@ func (c *VirtualEnvironmentClient) ListNetworks(nodeName string, d *VirtualEnvir
 
 	return resBody.Data, nil
 }
+
+// ReloadNetworkConfiguration applies the pending network changes on a node.
+func (c *VirtualEnvironmentClient) ReloadNetworkConfiguration(nodeName string, timeout int) error {
+	taskID, err := c.ReloadNetworkConfigurationAsync(nodeName)
+
+	if err != nil {
+		return err
+	}
+
+	err = c.WaitForNodeTask(nodeName, *taskID, timeout, 1)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReloadNetworkConfigurationAsync applies the pending network changes on a node asynchronously.
+func (c *VirtualEnvironmentClient) ReloadNetworkConfigurationAsync(nodeName string) (*string, error) {
+	resBody := &VirtualEnvironmentNetworkReloadResponseBody{}
+	err := c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/network", url.PathEscape(nodeName)), nil, resBody)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.Data == nil {
+		return nil, errors.New("The server did not include a data object in the response")
+	}
+
+	return resBody.Data, nil
+}
+
+// UpdateNetwork updates a network interface.
+func (c *VirtualEnvironmentClient) UpdateNetwork(nodeName string, iface string, d *VirtualEnvironmentNetworkUpdateRequestBody) error {
+	return c.DoRequest(hmPUT, fmt.Sprintf("nodes/%s/network/%s", url.PathEscape(nodeName), url.PathEscape(iface)), d, nil)
+}
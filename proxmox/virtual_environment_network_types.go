@@ -7,6 +7,38 @@ package proxmox
 //import (
 //)
 
+// VirtualEnvironmentNetworkCreateRequestBody contains the body for a network create request.
+type VirtualEnvironmentNetworkCreateRequestBody struct {
+	Address     *string     `json:"address,omitempty" url:"address,omitempty"`
+	Autostart   *CustomBool `json:"autostart,omitempty" url:"autostart,omitempty,int"`
+	BridgePorts *string     `json:"bridge_ports,omitempty" url:"bridge_ports,omitempty"`
+	CIDR        *string     `json:"cidr,omitempty" url:"cidr,omitempty"`
+	Comments    *string     `json:"comments,omitempty" url:"comments,omitempty"`
+	Gateway     *string     `json:"gateway,omitempty" url:"gateway,omitempty"`
+	Iface       string      `json:"iface" url:"iface"`
+	Netmask     *string     `json:"netmask,omitempty" url:"netmask,omitempty"`
+	Type        string      `json:"type" url:"type"`
+}
+
+// VirtualEnvironmentNetworkGetResponseBody contains the body from a network get response.
+type VirtualEnvironmentNetworkGetResponseBody struct {
+	Data *VirtualEnvironmentNetworkGetResponseData `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentNetworkGetResponseData contains the data from a network get response.
+type VirtualEnvironmentNetworkGetResponseData struct {
+	Active      *CustomBool `json:"active,omitempty"`
+	Address     *string     `json:"address,omitempty"`
+	Autostart   *CustomBool `json:"autostart,omitempty"`
+	BridgePorts *string     `json:"bridge_ports,omitempty"`
+	CIDR        *string     `json:"cidr,omitempty"`
+	Comments    *string     `json:"comments,omitempty"`
+	Gateway     *string     `json:"gateway,omitempty"`
+	Iface       string      `json:"iface"`
+	Netmask     *string     `json:"netmask,omitempty"`
+	Type        string      `json:"type"`
+}
+
 // VirtualEnvironmentNetworkListRequestBody contains the body for a network list request.
 type VirtualEnvironmentNetworkListRequestBody struct {
 	Type *string `json:"type,omitempty" url:"type,omitempty"`
@@ -20,6 +52,25 @@ type VirtualEnvironmentNetworkListResponseBody struct {
 // VirtualEnvironmentNetworkListResponseData contains the data from a network list response.
 type VirtualEnvironmentNetworkListResponseData struct {
 	Address  string `json:"address,omitempty"`
+	Iface    string `json:"iface,omitempty"`
 	Priority int    `json:"priority,omitempty"`
 	Type     string `json:"type,omitempty"`
 }
+
+// VirtualEnvironmentNetworkReloadResponseBody contains the body from a network reload response.
+type VirtualEnvironmentNetworkReloadResponseBody struct {
+	Data *string `json:"data,omitempty"`
+}
+
+// VirtualEnvironmentNetworkUpdateRequestBody contains the body for a network update request.
+type VirtualEnvironmentNetworkUpdateRequestBody struct {
+	Address     *string     `json:"address,omitempty" url:"address,omitempty"`
+	Autostart   *CustomBool `json:"autostart,omitempty" url:"autostart,omitempty,int"`
+	BridgePorts *string     `json:"bridge_ports,omitempty" url:"bridge_ports,omitempty"`
+	CIDR        *string     `json:"cidr,omitempty" url:"cidr,omitempty"`
+	Comments    *string     `json:"comments,omitempty" url:"comments,omitempty"`
+	Delete      []string    `json:"delete,omitempty" url:"delete,omitempty,comma"`
+	Gateway     *string     `json:"gateway,omitempty" url:"gateway,omitempty"`
+	Netmask     *string     `json:"netmask,omitempty" url:"netmask,omitempty"`
+	Type        string      `json:"type" url:"type"`
+}
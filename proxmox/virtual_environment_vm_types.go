@@ -426,6 +426,23 @@ type VirtualEnvironmentVMGetResponseData struct {
 	SCSIDevice11         *CustomStorageDevice          `json:"scsi11,omitempty"`
 	SCSIDevice12         *CustomStorageDevice          `json:"scsi12,omitempty"`
 	SCSIDevice13         *CustomStorageDevice          `json:"scsi13,omitempty"`
+	SCSIDevice14         *CustomStorageDevice          `json:"scsi14,omitempty"`
+	SCSIDevice15         *CustomStorageDevice          `json:"scsi15,omitempty"`
+	SCSIDevice16         *CustomStorageDevice          `json:"scsi16,omitempty"`
+	SCSIDevice17         *CustomStorageDevice          `json:"scsi17,omitempty"`
+	SCSIDevice18         *CustomStorageDevice          `json:"scsi18,omitempty"`
+	SCSIDevice19         *CustomStorageDevice          `json:"scsi19,omitempty"`
+	SCSIDevice20         *CustomStorageDevice          `json:"scsi20,omitempty"`
+	SCSIDevice21         *CustomStorageDevice          `json:"scsi21,omitempty"`
+	SCSIDevice22         *CustomStorageDevice          `json:"scsi22,omitempty"`
+	SCSIDevice23         *CustomStorageDevice          `json:"scsi23,omitempty"`
+	SCSIDevice24         *CustomStorageDevice          `json:"scsi24,omitempty"`
+	SCSIDevice25         *CustomStorageDevice          `json:"scsi25,omitempty"`
+	SCSIDevice26         *CustomStorageDevice          `json:"scsi26,omitempty"`
+	SCSIDevice27         *CustomStorageDevice          `json:"scsi27,omitempty"`
+	SCSIDevice28         *CustomStorageDevice          `json:"scsi28,omitempty"`
+	SCSIDevice29         *CustomStorageDevice          `json:"scsi29,omitempty"`
+	SCSIDevice30         *CustomStorageDevice          `json:"scsi30,omitempty"`
 	SCSIHardware         *string                       `json:"scsihw,omitempty"`
 	SerialDevice0        *string                       `json:"serial0,omitempty"`
 	SerialDevice1        *string                       `json:"serial1,omitempty"`
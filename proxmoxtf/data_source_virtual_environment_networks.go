@@ -7,13 +7,16 @@ package proxmoxtf
 import (
 	"fmt"
 
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const (
 	mkDataSourceVirtualEnvironmentNetworksAddresses  = "addresses"
+	mkDataSourceVirtualEnvironmentNetworksInterfaces = "interfaces"
 	mkDataSourceVirtualEnvironmentNetworksNodeName   = "node_name"
 	mkDataSourceVirtualEnvironmentNetworksPriorities = "priorities"
+	mkDataSourceVirtualEnvironmentNetworksType       = "type"
 	mkDataSourceVirtualEnvironmentNetworksTypes      = "types"
 )
 
@@ -26,6 +29,12 @@ func dataSourceVirtualEnvironmentNetworks() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			mkDataSourceVirtualEnvironmentNetworksInterfaces: {
+				Type:        schema.TypeList,
+				Description: "The network interface name",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			mkDataSourceVirtualEnvironmentNetworksNodeName: {
 				Type:        schema.TypeString,
 				Description: "The node name",
@@ -37,6 +46,12 @@ func dataSourceVirtualEnvironmentNetworks() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
+			mkDataSourceVirtualEnvironmentNetworksType: {
+				Type:         schema.TypeString,
+				Description:  "Only list network interfaces of a specific type",
+				Optional:     true,
+				ValidateFunc: getNetworkInterfaceTypeFilterValidator(),
+			},
 			mkDataSourceVirtualEnvironmentNetworksTypes: {
 				Type:        schema.TypeList,
 				Description: "The network interface type",
@@ -57,19 +72,35 @@ func dataSourceVirtualEnvironmentNetworkRead(d *schema.ResourceData, m interface
 	}
 
 	nodeName := d.Get(mkDataSourceVirtualEnvironmentNetworksNodeName).(string)
-	list, err := veClient.ListNetworks(nodeName, nil)
+	body := &proxmox.VirtualEnvironmentNetworkListRequestBody{}
+	networkType := d.Get(mkDataSourceVirtualEnvironmentNetworksType).(string)
+
+	if networkType != "" {
+		body.Type = &networkType
+	}
+
+	list, err := veClient.ListNetworks(nodeName, body)
 
 	if err != nil {
 		return err
 	}
 
 	addresses := make([]interface{}, len(list))
+	interfaces := make([]interface{}, len(list))
 	priorities := make([]interface{}, len(list))
 	types := make([]interface{}, len(list))
 
+	for i, v := range list {
+		addresses[i] = v.Address
+		interfaces[i] = v.Iface
+		priorities[i] = v.Priority
+		types[i] = v.Type
+	}
+
 	d.SetId(fmt.Sprintf("%s_networks", nodeName))
 
 	d.Set(mkDataSourceVirtualEnvironmentNetworksAddresses, addresses)
+	d.Set(mkDataSourceVirtualEnvironmentNetworksInterfaces, interfaces)
 	d.Set(mkDataSourceVirtualEnvironmentNetworksPriorities, priorities)
 	d.Set(mkDataSourceVirtualEnvironmentNetworksTypes, types)
 
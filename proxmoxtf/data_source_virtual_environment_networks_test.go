@@ -0,0 +1,128 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// TestDataSourceVirtualEnvironmentNetworksInstantiation tests whether the DataSourceVirtualEnvironmentNetworks instance can be instantiated.
+func TestDataSourceVirtualEnvironmentNetworksInstantiation(t *testing.T) {
+	s := dataSourceVirtualEnvironmentNetworks()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate dataSourceVirtualEnvironmentNetworks")
+	}
+}
+
+// TestDataSourceVirtualEnvironmentNetworksSchema tests the dataSourceVirtualEnvironmentNetworks schema.
+func TestDataSourceVirtualEnvironmentNetworksSchema(t *testing.T) {
+	s := dataSourceVirtualEnvironmentNetworks()
+
+	testRequiredArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentNetworksNodeName,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkDataSourceVirtualEnvironmentNetworksType,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkDataSourceVirtualEnvironmentNetworksAddresses,
+		mkDataSourceVirtualEnvironmentNetworksInterfaces,
+		mkDataSourceVirtualEnvironmentNetworksPriorities,
+		mkDataSourceVirtualEnvironmentNetworksTypes,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkDataSourceVirtualEnvironmentNetworksAddresses:  schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworksInterfaces: schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworksNodeName:   schema.TypeString,
+		mkDataSourceVirtualEnvironmentNetworksPriorities: schema.TypeList,
+		mkDataSourceVirtualEnvironmentNetworksType:       schema.TypeString,
+		mkDataSourceVirtualEnvironmentNetworksTypes:      schema.TypeList,
+	})
+}
+
+// TestDataSourceVirtualEnvironmentNetworksRead tests that dataSourceVirtualEnvironmentNetworkRead copies the server response in priority order.
+func TestDataSourceVirtualEnvironmentNetworksRead(t *testing.T) {
+	d := testDataSourceVirtualEnvironmentNetworksRead(t, "", `[`+
+		`{"address":"10.0.0.2","iface":"vmbr0","priority":5,"type":"bridge"},`+
+		`{"address":"10.0.0.1","iface":"eno1","priority":3,"type":"eth"},`+
+		`{"address":"10.0.0.3","iface":"bond0","priority":7,"type":"bond"}`+
+		`]`)
+
+	testDataSourceVirtualEnvironmentNetworksAttributes(t, d, map[string]interface{}{
+		mkDataSourceVirtualEnvironmentNetworksAddresses:  []interface{}{"10.0.0.1", "10.0.0.2", "10.0.0.3"},
+		mkDataSourceVirtualEnvironmentNetworksInterfaces: []interface{}{"eno1", "vmbr0", "bond0"},
+		mkDataSourceVirtualEnvironmentNetworksPriorities: []interface{}{3, 5, 7},
+		mkDataSourceVirtualEnvironmentNetworksTypes:      []interface{}{"eth", "bridge", "bond"},
+	})
+}
+
+// TestDataSourceVirtualEnvironmentNetworksReadType tests that dataSourceVirtualEnvironmentNetworkRead passes the type filter to the server.
+func TestDataSourceVirtualEnvironmentNetworksReadType(t *testing.T) {
+	d := testDataSourceVirtualEnvironmentNetworksRead(t, "bridge", `[`+
+		`{"address":"10.0.1.1","iface":"vmbr1","priority":6,"type":"bridge"},`+
+		`{"address":"10.0.0.1","iface":"vmbr0","priority":4,"type":"bridge"}`+
+		`]`)
+
+	testDataSourceVirtualEnvironmentNetworksAttributes(t, d, map[string]interface{}{
+		mkDataSourceVirtualEnvironmentNetworksAddresses:  []interface{}{"10.0.0.1", "10.0.1.1"},
+		mkDataSourceVirtualEnvironmentNetworksInterfaces: []interface{}{"vmbr0", "vmbr1"},
+		mkDataSourceVirtualEnvironmentNetworksPriorities: []interface{}{4, 6},
+		mkDataSourceVirtualEnvironmentNetworksTypes:      []interface{}{"bridge", "bridge"},
+	})
+}
+
+func testDataSourceVirtualEnvironmentNetworksRead(t *testing.T, networkType string, data string) *schema.ResourceData {
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api2/json/nodes/pve/network" {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("type") != networkType {
+			t.Errorf("Unexpected type filter %q (expected: %q)", r.URL.Query().Get("type"), networkType)
+		}
+
+		w.Write([]byte(`{"data":` + data + `}`))
+	})
+	defer closeServer()
+
+	raw := map[string]interface{}{
+		mkDataSourceVirtualEnvironmentNetworksNodeName: "pve",
+	}
+
+	if networkType != "" {
+		raw[mkDataSourceVirtualEnvironmentNetworksType] = networkType
+	}
+
+	s := dataSourceVirtualEnvironmentNetworks()
+	d := schema.TestResourceDataRaw(t, s.Schema, raw)
+
+	err := dataSourceVirtualEnvironmentNetworkRead(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to read networks: %s", err.Error())
+	}
+
+	return d
+}
+
+func testDataSourceVirtualEnvironmentNetworksAttributes(t *testing.T, d *schema.ResourceData, expected map[string]interface{}) {
+	for k, v := range expected {
+		actual := d.Get(k)
+
+		if !reflect.DeepEqual(actual, v) {
+			t.Fatalf("Attribute %s has value %v (expected: %v)", k, actual, v)
+		}
+	}
+}
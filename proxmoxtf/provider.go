@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -37,6 +38,9 @@ type providerConfiguration struct {
 	veClient *proxmox.VirtualEnvironmentClient
 }
 
+// veNodeNetworkMutexKV serializes network changes and reloads per node.
+var veNodeNetworkMutexKV = mutexkv.NewMutexKV()
+
 // Provider returns the object for this provider.
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -61,19 +65,20 @@ func Provider() *schema.Provider {
 			"proxmox_virtual_environment_version":         dataSourceVirtualEnvironmentVersion(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"proxmox_virtual_environment_certificate":   resourceVirtualEnvironmentCertificate(),
-			"proxmox_virtual_environment_cluster_alias": resourceVirtualEnvironmentClusterAlias(),
-			"proxmox_virtual_environment_cluster_ipset": resourceVirtualEnvironmentClusterIPSet(),
-			"proxmox_virtual_environment_container":     resourceVirtualEnvironmentContainer(),
-			"proxmox_virtual_environment_dns":           resourceVirtualEnvironmentDNS(),
-			"proxmox_virtual_environment_file":          resourceVirtualEnvironmentFile(),
-			"proxmox_virtual_environment_group":         resourceVirtualEnvironmentGroup(),
-			"proxmox_virtual_environment_hosts":         resourceVirtualEnvironmentHosts(),
-			"proxmox_virtual_environment_pool":          resourceVirtualEnvironmentPool(),
-			"proxmox_virtual_environment_role":          resourceVirtualEnvironmentRole(),
-			"proxmox_virtual_environment_time":          resourceVirtualEnvironmentTime(),
-			"proxmox_virtual_environment_user":          resourceVirtualEnvironmentUser(),
-			"proxmox_virtual_environment_vm":            resourceVirtualEnvironmentVM(),
+			"proxmox_virtual_environment_certificate":       resourceVirtualEnvironmentCertificate(),
+			"proxmox_virtual_environment_cluster_alias":     resourceVirtualEnvironmentClusterAlias(),
+			"proxmox_virtual_environment_cluster_ipset":     resourceVirtualEnvironmentClusterIPSet(),
+			"proxmox_virtual_environment_container":         resourceVirtualEnvironmentContainer(),
+			"proxmox_virtual_environment_dns":               resourceVirtualEnvironmentDNS(),
+			"proxmox_virtual_environment_file":              resourceVirtualEnvironmentFile(),
+			"proxmox_virtual_environment_group":             resourceVirtualEnvironmentGroup(),
+			"proxmox_virtual_environment_hosts":             resourceVirtualEnvironmentHosts(),
+			"proxmox_virtual_environment_network_interface": resourceVirtualEnvironmentNetworkInterface(),
+			"proxmox_virtual_environment_pool":              resourceVirtualEnvironmentPool(),
+			"proxmox_virtual_environment_role":              resourceVirtualEnvironmentRole(),
+			"proxmox_virtual_environment_time":              resourceVirtualEnvironmentTime(),
+			"proxmox_virtual_environment_user":              resourceVirtualEnvironmentUser(),
+			"proxmox_virtual_environment_vm":                resourceVirtualEnvironmentVM(),
 		},
 		Schema: map[string]*schema.Schema{
 			mkProviderVirtualEnvironment: {
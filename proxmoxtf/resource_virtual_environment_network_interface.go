@@ -0,0 +1,390 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const (
+	dvResourceVirtualEnvironmentNetworkInterfaceAutostart     = true
+	dvResourceVirtualEnvironmentNetworkInterfaceBridgePorts   = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceCIDR          = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceComments      = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceGateway       = ""
+	dvResourceVirtualEnvironmentNetworkInterfaceTimeoutReload = 60
+
+	mkResourceVirtualEnvironmentNetworkInterfaceAddress       = "address"
+	mkResourceVirtualEnvironmentNetworkInterfaceAutostart     = "autostart"
+	mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts   = "bridge_ports"
+	mkResourceVirtualEnvironmentNetworkInterfaceCIDR          = "cidr"
+	mkResourceVirtualEnvironmentNetworkInterfaceComments      = "comments"
+	mkResourceVirtualEnvironmentNetworkInterfaceGateway       = "gateway"
+	mkResourceVirtualEnvironmentNetworkInterfaceIface         = "iface"
+	mkResourceVirtualEnvironmentNetworkInterfaceNetmask       = "netmask"
+	mkResourceVirtualEnvironmentNetworkInterfaceNodeName      = "node_name"
+	mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload = "timeout_reload"
+	mkResourceVirtualEnvironmentNetworkInterfaceType          = "type"
+)
+
+func resourceVirtualEnvironmentNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			mkResourceVirtualEnvironmentNetworkInterfaceAddress: {
+				Type:        schema.TypeString,
+				Description: "The IPv4 address",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceAutostart: {
+				Type:        schema.TypeBool,
+				Description: "Whether to automatically start the network interface on boot",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceAutostart,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts: {
+				Type:        schema.TypeString,
+				Description: "The space separated list of bridge ports",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceBridgePorts,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceCIDR: {
+				Type:        schema.TypeString,
+				Description: "The IPv4 CIDR",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceCIDR,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceComments: {
+				Type:        schema.TypeString,
+				Description: "The comments",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceComments,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceGateway: {
+				Type:        schema.TypeString,
+				Description: "The IPv4 gateway",
+				Optional:    true,
+				Default:     dvResourceVirtualEnvironmentNetworkInterfaceGateway,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceIface: {
+				Type:        schema.TypeString,
+				Description: "The network interface name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceNetmask: {
+				Type:        schema.TypeString,
+				Description: "The IPv4 network mask",
+				Computed:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceNodeName: {
+				Type:        schema.TypeString,
+				Description: "The node name",
+				Required:    true,
+				ForceNew:    true,
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload: {
+				Type:         schema.TypeInt,
+				Description:  "The timeout in seconds for applying the network configuration",
+				Optional:     true,
+				Default:      dvResourceVirtualEnvironmentNetworkInterfaceTimeoutReload,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			mkResourceVirtualEnvironmentNetworkInterfaceType: {
+				Type:         schema.TypeString,
+				Description:  "The network interface type",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: getNetworkInterfaceTypeValidator(),
+			},
+		},
+		Create: resourceVirtualEnvironmentNetworkInterfaceCreate,
+		Read:   resourceVirtualEnvironmentNetworkInterfaceRead,
+		Update: resourceVirtualEnvironmentNetworkInterfaceUpdate,
+		Delete: resourceVirtualEnvironmentNetworkInterfaceDelete,
+	}
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceCreate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	autostart := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAutostart).(bool))
+	bridgePorts := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts).(string)
+	cidr := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceCIDR).(string)
+	comments := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceComments).(string)
+	gateway := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceGateway).(string)
+	iface := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceIface).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceNodeName).(string)
+	networkType := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceType).(string)
+
+	body := &proxmox.VirtualEnvironmentNetworkCreateRequestBody{
+		Autostart: &autostart,
+		Iface:     iface,
+		Type:      networkType,
+	}
+
+	if bridgePorts != "" {
+		body.BridgePorts = &bridgePorts
+	}
+
+	if cidr != "" {
+		body.CIDR = &cidr
+	}
+
+	if comments != "" {
+		body.Comments = &comments
+	}
+
+	if gateway != "" {
+		body.Gateway = &gateway
+	}
+
+	// The reload applies every pending change on the node, which is why changes are serialized per node.
+	veNodeNetworkMutexKV.Lock(nodeName)
+	defer veNodeNetworkMutexKV.Unlock(nodeName)
+
+	err = veClient.CreateNetwork(nodeName, body)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", nodeName, iface))
+
+	err = resourceVirtualEnvironmentNetworkInterfaceReload(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceVirtualEnvironmentNetworkInterfaceRead(d, m)
+}
+
+// resourceVirtualEnvironmentNetworkInterfaceIsNotFound reports whether an error indicates that the interface does not exist.
+// The API rejects unknown interfaces with a parameter verification error instead of an HTTP 404 response.
+func resourceVirtualEnvironmentNetworkInterfaceIsNotFound(err error) bool {
+	return strings.Contains(err.Error(), "HTTP 404") ||
+		(strings.Contains(err.Error(), "HTTP 400") && strings.Contains(err.Error(), "interface does not exist"))
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	iface := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceIface).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceNodeName).(string)
+	network, err := veClient.GetNetwork(nodeName, iface)
+
+	if err != nil {
+		if resourceVirtualEnvironmentNetworkInterfaceIsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	networkMap := map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceAddress:     "",
+		mkResourceVirtualEnvironmentNetworkInterfaceAutostart:   false,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts: "",
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:        "",
+		mkResourceVirtualEnvironmentNetworkInterfaceComments:    "",
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway:     "",
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:       network.Iface,
+		mkResourceVirtualEnvironmentNetworkInterfaceNetmask:     "",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:        network.Type,
+	}
+
+	if network.Address != nil {
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceAddress] = *network.Address
+	}
+
+	if network.Autostart != nil {
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceAutostart] = bool(*network.Autostart)
+	}
+
+	if network.BridgePorts != nil {
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts] = *network.BridgePorts
+	}
+
+	if network.CIDR != nil {
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceCIDR] = *network.CIDR
+	}
+
+	if network.Comments != nil {
+		// The API returns the comments with the trailing line break from the interfaces file.
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceComments] = strings.TrimRight(*network.Comments, "\n")
+	}
+
+	if network.Gateway != nil {
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceGateway] = *network.Gateway
+	}
+
+	if network.Netmask != nil {
+		networkMap[mkResourceVirtualEnvironmentNetworkInterfaceNetmask] = *network.Netmask
+	}
+
+	for key, val := range networkMap {
+		err = d.Set(key, val)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceReload(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceNodeName).(string)
+	timeout := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload).(int)
+
+	err = veClient.ReloadNetworkConfiguration(nodeName, timeout)
+
+	if err != nil {
+		return fmt.Errorf("The network configuration of node \"%s\" has pending changes which could not be applied - Reason: %s", nodeName, err.Error())
+	}
+
+	return nil
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceUpdate(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	// Avoid reloading the network configuration of the node when only the timeout has changed.
+	if !d.HasChanges(
+		mkResourceVirtualEnvironmentNetworkInterfaceAutostart,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR,
+		mkResourceVirtualEnvironmentNetworkInterfaceComments,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway,
+	) {
+		return nil
+	}
+
+	autostart := proxmox.CustomBool(d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAutostart).(bool))
+	bridgePorts := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts).(string)
+	cidr := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceCIDR).(string)
+	comments := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceComments).(string)
+	gateway := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceGateway).(string)
+	iface := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceIface).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceNodeName).(string)
+	networkType := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceType).(string)
+
+	body := &proxmox.VirtualEnvironmentNetworkUpdateRequestBody{
+		Autostart: &autostart,
+		Delete:    []string{},
+		Type:      networkType,
+	}
+
+	if bridgePorts != "" {
+		body.BridgePorts = &bridgePorts
+	} else {
+		body.Delete = append(body.Delete, "bridge_ports")
+	}
+
+	// The address and the network mask are derived from the CIDR and must not be sent alongside it.
+	if cidr != "" {
+		body.CIDR = &cidr
+	} else {
+		body.Delete = append(body.Delete, "address", "cidr", "netmask")
+	}
+
+	if comments != "" {
+		body.Comments = &comments
+	} else {
+		body.Delete = append(body.Delete, "comments")
+	}
+
+	if gateway != "" {
+		body.Gateway = &gateway
+	} else {
+		body.Delete = append(body.Delete, "gateway")
+	}
+
+	veNodeNetworkMutexKV.Lock(nodeName)
+	defer veNodeNetworkMutexKV.Unlock(nodeName)
+
+	// Keep the previous values in the state until the changes have been applied in order for a failed reload to be retried.
+	d.Partial(true)
+
+	err = veClient.UpdateNetwork(nodeName, iface, body)
+
+	if err != nil {
+		return err
+	}
+
+	err = resourceVirtualEnvironmentNetworkInterfaceReload(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	d.Partial(false)
+
+	return resourceVirtualEnvironmentNetworkInterfaceRead(d, m)
+}
+
+func resourceVirtualEnvironmentNetworkInterfaceDelete(d *schema.ResourceData, m interface{}) error {
+	config := m.(providerConfiguration)
+	veClient, err := config.GetVEClient()
+
+	if err != nil {
+		return err
+	}
+
+	iface := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceIface).(string)
+	nodeName := d.Get(mkResourceVirtualEnvironmentNetworkInterfaceNodeName).(string)
+
+	veNodeNetworkMutexKV.Lock(nodeName)
+	defer veNodeNetworkMutexKV.Unlock(nodeName)
+
+	err = veClient.DeleteNetwork(nodeName, iface)
+
+	if err != nil {
+		if resourceVirtualEnvironmentNetworkInterfaceIsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	err = resourceVirtualEnvironmentNetworkInterfaceReload(d, m)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
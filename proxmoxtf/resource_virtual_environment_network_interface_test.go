@@ -0,0 +1,544 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// TestResourceVirtualEnvironmentNetworkInterfaceInstantiation tests whether the ResourceVirtualEnvironmentNetworkInterface instance can be instantiated.
+func TestResourceVirtualEnvironmentNetworkInterfaceInstantiation(t *testing.T) {
+	s := resourceVirtualEnvironmentNetworkInterface()
+
+	if s == nil {
+		t.Fatalf("Cannot instantiate resourceVirtualEnvironmentNetworkInterface")
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceSchema tests the resourceVirtualEnvironmentNetworkInterface schema.
+func TestResourceVirtualEnvironmentNetworkInterfaceSchema(t *testing.T) {
+	s := resourceVirtualEnvironmentNetworkInterface()
+
+	testRequiredArguments(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkInterfaceIface,
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName,
+		mkResourceVirtualEnvironmentNetworkInterfaceType,
+	})
+
+	testOptionalArguments(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkInterfaceAutostart,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR,
+		mkResourceVirtualEnvironmentNetworkInterfaceComments,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway,
+		mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload,
+	})
+
+	testComputedAttributes(t, s, []string{
+		mkResourceVirtualEnvironmentNetworkInterfaceAddress,
+		mkResourceVirtualEnvironmentNetworkInterfaceNetmask,
+	})
+
+	testValueTypes(t, s, map[string]schema.ValueType{
+		mkResourceVirtualEnvironmentNetworkInterfaceAddress:       schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceAutostart:     schema.TypeBool,
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts:   schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:          schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceComments:      schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceGateway:       schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:         schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceNetmask:       schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName:      schema.TypeString,
+		mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload: schema.TypeInt,
+		mkResourceVirtualEnvironmentNetworkInterfaceType:          schema.TypeString,
+	})
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceCreate tests that creating a network interface applies the pending changes.
+func TestResourceVirtualEnvironmentNetworkInterfaceCreate(t *testing.T) {
+	requests := []string{}
+
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api2/json/nodes/pve/network":
+			r.ParseForm()
+
+			if r.PostForm.Get("iface") != "vmbr1" || r.PostForm.Get("type") != "bridge" || r.PostForm.Get("bridge_ports") != "eno2" {
+				t.Errorf("Unexpected create request body %s", r.PostForm.Encode())
+			}
+
+			w.Write([]byte(`{"data":null}`))
+		case "PUT /api2/json/nodes/pve/network":
+			w.Write([]byte(`{"data":"UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:"}`))
+		case "GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status":
+			w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+		case "GET /api2/json/nodes/pve/network/vmbr1":
+			w.Write([]byte(`{"data":{"iface":"vmbr1","type":"bridge","address":"10.0.1.1","netmask":"255.255.255.0","cidr":"10.0.1.1/24","bridge_ports":"eno2","autostart":1,"comments":"Lab\n"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts: "eno2",
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:        "10.0.1.1/24",
+		mkResourceVirtualEnvironmentNetworkInterfaceComments:    "Lab",
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:       "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName:    "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:        "bridge",
+	})
+
+	err := resourceVirtualEnvironmentNetworkInterfaceCreate(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to create network interface: %s", err.Error())
+	}
+
+	expectedRequests := []string{
+		"POST /api2/json/nodes/pve/network",
+		"PUT /api2/json/nodes/pve/network",
+		"GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status",
+		"GET /api2/json/nodes/pve/network/vmbr1",
+	}
+
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Fatalf("Unexpected requests %v (expected: %v)", requests, expectedRequests)
+	}
+
+	if d.Id() != "pve_vmbr1" {
+		t.Fatalf("Unexpected ID %s", d.Id())
+	}
+
+	if d.Get(mkResourceVirtualEnvironmentNetworkInterfaceComments).(string) != "Lab" {
+		t.Fatalf("Unexpected comments %q", d.Get(mkResourceVirtualEnvironmentNetworkInterfaceComments))
+	}
+
+	if d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAddress).(string) != "10.0.1.1" {
+		t.Fatalf("Unexpected address %q", d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAddress))
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceUpdate tests that updating the CIDR of a network interface does not send the previous address.
+func TestResourceVirtualEnvironmentNetworkInterfaceUpdate(t *testing.T) {
+	requests := []string{}
+
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /api2/json/nodes/pve/network/vmbr1":
+			r.ParseForm()
+
+			expected := map[string][]string{
+				"autostart":    {"1"},
+				"bridge_ports": {"eno2"},
+				"cidr":         {"10.0.2.1/24"},
+				"comments":     {"Lab"},
+				"delete":       {"gateway"},
+				"type":         {"bridge"},
+			}
+
+			if !reflect.DeepEqual(map[string][]string(r.PostForm), expected) {
+				t.Errorf("Unexpected update request body %v (expected: %v)", r.PostForm, expected)
+			}
+
+			w.Write([]byte(`{"data":null}`))
+		case "PUT /api2/json/nodes/pve/network":
+			w.Write([]byte(`{"data":"UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:"}`))
+		case "GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status":
+			w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+		case "GET /api2/json/nodes/pve/network/vmbr1":
+			w.Write([]byte(`{"data":{"iface":"vmbr1","type":"bridge","address":"10.0.2.1","netmask":"255.255.255.0","cidr":"10.0.2.1/24","bridge_ports":"eno2","autostart":1,"comments":"Lab\n"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceBridgePorts: "eno2",
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:        "10.0.2.1/24",
+		mkResourceVirtualEnvironmentNetworkInterfaceComments:    "Lab",
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:       "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName:    "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:        "bridge",
+	})
+	d.SetId("pve_vmbr1")
+
+	// Simulate the server values stored in the state by a previous read.
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceAddress, "10.0.1.1")
+	d.Set(mkResourceVirtualEnvironmentNetworkInterfaceNetmask, "255.255.255.0")
+
+	err := resourceVirtualEnvironmentNetworkInterfaceUpdate(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to update network interface: %s", err.Error())
+	}
+
+	expectedRequests := []string{
+		"PUT /api2/json/nodes/pve/network/vmbr1",
+		"PUT /api2/json/nodes/pve/network",
+		"GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status",
+		"GET /api2/json/nodes/pve/network/vmbr1",
+	}
+
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Fatalf("Unexpected requests %v (expected: %v)", requests, expectedRequests)
+	}
+
+	if d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAddress).(string) != "10.0.2.1" {
+		t.Fatalf("Unexpected address %q", d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAddress))
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceUpdateClearCIDR tests that removing the CIDR clears the IPv4 configuration.
+func TestResourceVirtualEnvironmentNetworkInterfaceUpdateClearCIDR(t *testing.T) {
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /api2/json/nodes/pve/network/vmbr1":
+			r.ParseForm()
+
+			if r.PostForm.Get("cidr") != "" || r.PostForm.Get("address") != "" || r.PostForm.Get("netmask") != "" {
+				t.Errorf("Unexpected IPv4 configuration in update request body %s", r.PostForm.Encode())
+			}
+
+			if r.PostForm.Get("delete") != "bridge_ports,address,cidr,netmask,comments,gateway" {
+				t.Errorf("Unexpected delete list %q", r.PostForm.Get("delete"))
+			}
+
+			w.Write([]byte(`{"data":null}`))
+		case "PUT /api2/json/nodes/pve/network":
+			w.Write([]byte(`{"data":"UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:"}`))
+		case "GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status":
+			w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+		case "GET /api2/json/nodes/pve/network/vmbr1":
+			w.Write([]byte(`{"data":{"iface":"vmbr1","type":"bridge","autostart":1}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	})
+	d.SetId("pve_vmbr1")
+
+	err := resourceVirtualEnvironmentNetworkInterfaceUpdate(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to update network interface: %s", err.Error())
+	}
+
+	if d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAddress).(string) != "" {
+		t.Fatalf("Unexpected address %q", d.Get(mkResourceVirtualEnvironmentNetworkInterfaceAddress))
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceDelete tests that deleting a network interface applies the pending changes.
+func TestResourceVirtualEnvironmentNetworkInterfaceDelete(t *testing.T) {
+	requests := []string{}
+
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method + " " + r.URL.Path {
+		case "DELETE /api2/json/nodes/pve/network/vmbr1":
+			w.Write([]byte(`{"data":null}`))
+		case "PUT /api2/json/nodes/pve/network":
+			w.Write([]byte(`{"data":"UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:"}`))
+		case "GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status":
+			w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	})
+	d.SetId("pve_vmbr1")
+
+	err := resourceVirtualEnvironmentNetworkInterfaceDelete(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to delete network interface: %s", err.Error())
+	}
+
+	expectedRequests := []string{
+		"DELETE /api2/json/nodes/pve/network/vmbr1",
+		"PUT /api2/json/nodes/pve/network",
+		"GET /api2/json/nodes/pve/tasks/UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:/status",
+	}
+
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Fatalf("Unexpected requests %v (expected: %v)", requests, expectedRequests)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceCreateReloadFailure tests that a failed reload surfaces the pending changes.
+func TestResourceVirtualEnvironmentNetworkInterfaceCreateReloadFailure(t *testing.T) {
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api2/json/nodes/pve/network":
+			w.Write([]byte(`{"data":null}`))
+		case "PUT /api2/json/nodes/pve/network":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"data":null}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	})
+
+	err := resourceVirtualEnvironmentNetworkInterfaceCreate(d, providerConfiguration{veClient: veClient})
+
+	if err == nil || !strings.Contains(err.Error(), "pending changes") {
+		t.Fatalf("Expected a pending changes error, got %v", err)
+	}
+
+	if d.Id() != "pve_vmbr1" {
+		t.Fatalf("Expected the staged network interface to be tracked, got ID %q", d.Id())
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceUpdateReloadFailure tests that a failed reload keeps the previous values in the state.
+func TestResourceVirtualEnvironmentNetworkInterfaceUpdateReloadFailure(t *testing.T) {
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /api2/json/nodes/pve/network/vmbr1":
+			w.Write([]byte(`{"data":null}`))
+		case "PUT /api2/json/nodes/pve/network":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"data":null}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := testResourceDataWithState(t, s, "pve_vmbr1", map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:     "10.0.1.1/24",
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	}, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:     "10.0.2.1/24",
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	})
+
+	err := resourceVirtualEnvironmentNetworkInterfaceUpdate(d, providerConfiguration{veClient: veClient})
+
+	if err == nil || !strings.Contains(err.Error(), "pending changes") {
+		t.Fatalf("Expected a pending changes error, got %v", err)
+	}
+
+	state := d.State()
+
+	if state == nil || state.Attributes[mkResourceVirtualEnvironmentNetworkInterfaceCIDR] != "10.0.1.1/24" {
+		t.Fatalf("Expected the previous CIDR to be kept in the state, got %v", state)
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceCreateConcurrent tests that changes and reloads on the same node do not overlap.
+func TestResourceVirtualEnvironmentNetworkInterfaceCreateConcurrent(t *testing.T) {
+	var lock sync.Mutex
+	active := 0
+
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api2/json/nodes/pve/network":
+			lock.Lock()
+			active++
+
+			if active > 1 {
+				t.Errorf("Network changes on node pve overlap")
+			}
+
+			lock.Unlock()
+
+			time.Sleep(50 * time.Millisecond)
+
+			w.Write([]byte(`{"data":null}`))
+		case r.Method == "PUT" && r.URL.Path == "/api2/json/nodes/pve/network":
+			w.Write([]byte(`{"data":"UPID:pve:00001234:00005678:00000000:srvreload:networking:root@pam:"}`))
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api2/json/nodes/pve/tasks/"):
+			lock.Lock()
+			active--
+			lock.Unlock()
+
+			w.Write([]byte(`{"data":{"status":"stopped","exitstatus":"OK"}}`))
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api2/json/nodes/pve/network/"):
+			iface := strings.TrimPrefix(r.URL.Path, "/api2/json/nodes/pve/network/")
+			w.Write([]byte(`{"data":{"iface":"` + iface + `","type":"bridge","autostart":1}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer closeServer()
+
+	var wg sync.WaitGroup
+
+	for _, iface := range []string{"vmbr1", "vmbr2", "vmbr3"} {
+		s := resourceVirtualEnvironmentNetworkInterface()
+		d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+			mkResourceVirtualEnvironmentNetworkInterfaceIface:    iface,
+			mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+			mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+		})
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := resourceVirtualEnvironmentNetworkInterfaceCreate(d, providerConfiguration{veClient: veClient})
+
+			if err != nil {
+				t.Errorf("Failed to create network interface: %s", err.Error())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceReadNotFound tests that a network interface removed outside of Terraform is removed from the state.
+func TestResourceVirtualEnvironmentNetworkInterfaceReadNotFound(t *testing.T) {
+	veClient, closeServer := testVirtualEnvironmentClient(t, testResourceVirtualEnvironmentNetworkInterfaceNotFoundHandler(t, "GET"))
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	})
+	d.SetId("pve_vmbr1")
+
+	err := resourceVirtualEnvironmentNetworkInterfaceRead(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to read network interface: %s", err.Error())
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceDeleteNotFound tests that deleting a network interface removed outside of Terraform succeeds.
+func TestResourceVirtualEnvironmentNetworkInterfaceDeleteNotFound(t *testing.T) {
+	veClient, closeServer := testVirtualEnvironmentClient(t, testResourceVirtualEnvironmentNetworkInterfaceNotFoundHandler(t, "DELETE"))
+	defer closeServer()
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	})
+	d.SetId("pve_vmbr1")
+
+	err := resourceVirtualEnvironmentNetworkInterfaceDelete(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to delete network interface: %s", err.Error())
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("Unexpected ID %q", d.Id())
+	}
+}
+
+func testResourceVirtualEnvironmentNetworkInterfaceNotFoundHandler(t *testing.T, method string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.Path != "/api2/json/nodes/pve/network/vmbr1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"data":null,"errors":{"iface":"interface does not exist"}}`))
+	}
+}
+
+// TestResourceVirtualEnvironmentNetworkInterfaceUpdateTimeoutOnly tests that changing only the reload timeout does not touch the node.
+func TestResourceVirtualEnvironmentNetworkInterfaceUpdateTimeoutOnly(t *testing.T) {
+	veClient, closeServer := testVirtualEnvironmentClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	defer closeServer()
+
+	config := map[string]interface{}{
+		mkResourceVirtualEnvironmentNetworkInterfaceCIDR:     "10.0.1.1/24",
+		mkResourceVirtualEnvironmentNetworkInterfaceIface:    "vmbr1",
+		mkResourceVirtualEnvironmentNetworkInterfaceNodeName: "pve",
+		mkResourceVirtualEnvironmentNetworkInterfaceType:     "bridge",
+	}
+
+	newConfig := map[string]interface{}{}
+
+	for k, v := range config {
+		newConfig[k] = v
+	}
+
+	newConfig[mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload] = 120
+
+	s := resourceVirtualEnvironmentNetworkInterface()
+	d := testResourceDataWithState(t, s, "pve_vmbr1", config, newConfig)
+
+	if !d.HasChange(mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload) {
+		t.Fatalf("Expected a change of %s", mkResourceVirtualEnvironmentNetworkInterfaceTimeoutReload)
+	}
+
+	err := resourceVirtualEnvironmentNetworkInterfaceUpdate(d, providerConfiguration{veClient: veClient})
+
+	if err != nil {
+		t.Fatalf("Failed to update network interface: %s", err.Error())
+	}
+}
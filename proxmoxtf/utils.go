@@ -7,6 +7,7 @@ package proxmoxtf
 import (
 	"fmt"
 	"math"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -36,6 +37,9 @@ func getContentTypeValidator() schema.SchemaValidateFunc {
 }
 
 func getCPUFlagsValidator() schema.SchemaValidateFunc {
+	// The flag names follow the format accepted by Proxmox, which includes flags like "sse4.1" and "sse4_1".
+	r := regexp.MustCompile(`^[+-][a-zA-Z0-9][a-zA-Z0-9\-_.]*$`)
+
 	return func(i interface{}, k string) (ws []string, es []error) {
 		list, ok := i.([]interface{})
 
@@ -44,33 +48,6 @@ func getCPUFlagsValidator() schema.SchemaValidateFunc {
 			return
 		}
 
-		validator := validation.StringInSlice([]string{
-			"+aes",
-			"-aes",
-			"+amd-no-ssb",
-			"-amd-no-ssb",
-			"+amd-ssbd",
-			"-amd-ssbd",
-			"+hv-evmcs",
-			"-hv-evmcs",
-			"+hv-tlbflush",
-			"-hv-tlbflush",
-			"+ibpb",
-			"-ibpb",
-			"+md-clear",
-			"-md-clear",
-			"+pcid",
-			"-pcid",
-			"+pdpe1gb",
-			"-pdpe1gb",
-			"+spec-ctrl",
-			"-spec-ctrl",
-			"+ssbd",
-			"-ssbd",
-			"+virt-ssbd",
-			"-virt-ssbd",
-		}, false)
-
 		for li, lv := range list {
 			v, ok := lv.(string)
 
@@ -79,12 +56,8 @@ func getCPUFlagsValidator() schema.SchemaValidateFunc {
 				return
 			}
 
-			warns, errs := validator(v, k)
-
-			ws = append(ws, warns...)
-			es = append(es, errs...)
-
-			if len(es) > 0 {
+			if !r.MatchString(v) {
+				es = append(es, fmt.Errorf("expected %s[%d] to be a valid CPU flag (+aes or -aes), got %s", k, li, v))
 				return
 			}
 		}
@@ -240,6 +213,35 @@ func getNetworkDeviceModelValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{"e1000", "rtl8139", "virtio", "vmxnet3"}, false)
 }
 
+func getNetworkInterfaceTypeFilterValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"alias",
+		"any_bridge",
+		"bond",
+		"bridge",
+		"eth",
+		"OVSBond",
+		"OVSBridge",
+		"OVSIntPort",
+		"OVSPort",
+		"vlan",
+	}, false)
+}
+
+func getNetworkInterfaceTypeValidator() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"alias",
+		"bond",
+		"bridge",
+		"eth",
+		"OVSBond",
+		"OVSBridge",
+		"OVSIntPort",
+		"OVSPort",
+		"vlan",
+	}, false)
+}
+
 func getQEMUAgentTypeValidator() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{"isa", "virtio"}, false)
 }
@@ -398,49 +400,25 @@ func getDiskInfo(vm *proxmox.VirtualEnvironmentVMGetResponseData, d *schema.Reso
 	}
 
 	storageDevices := map[string]*proxmox.CustomStorageDevice{}
+	storageDeviceType := reflect.TypeOf((*proxmox.CustomStorageDevice)(nil))
 
-	storageDevices["ide0"] = vm.IDEDevice0
-	storageDevices["ide1"] = vm.IDEDevice1
-	storageDevices["ide2"] = vm.IDEDevice2
-
-	storageDevices["sata0"] = vm.SATADevice0
-	storageDevices["sata1"] = vm.SATADevice1
-	storageDevices["sata2"] = vm.SATADevice2
-	storageDevices["sata3"] = vm.SATADevice3
-	storageDevices["sata4"] = vm.SATADevice4
-	storageDevices["sata5"] = vm.SATADevice5
-
-	storageDevices["scsi0"] = vm.SCSIDevice0
-	storageDevices["scsi1"] = vm.SCSIDevice1
-	storageDevices["scsi2"] = vm.SCSIDevice2
-	storageDevices["scsi3"] = vm.SCSIDevice3
-	storageDevices["scsi4"] = vm.SCSIDevice4
-	storageDevices["scsi5"] = vm.SCSIDevice5
-	storageDevices["scsi6"] = vm.SCSIDevice6
-	storageDevices["scsi7"] = vm.SCSIDevice7
-	storageDevices["scsi8"] = vm.SCSIDevice8
-	storageDevices["scsi9"] = vm.SCSIDevice9
-	storageDevices["scsi10"] = vm.SCSIDevice10
-	storageDevices["scsi11"] = vm.SCSIDevice11
-	storageDevices["scsi12"] = vm.SCSIDevice12
-	storageDevices["scsi13"] = vm.SCSIDevice13
-
-	storageDevices["virtio0"] = vm.VirtualIODevice0
-	storageDevices["virtio1"] = vm.VirtualIODevice1
-	storageDevices["virtio2"] = vm.VirtualIODevice2
-	storageDevices["virtio3"] = vm.VirtualIODevice3
-	storageDevices["virtio4"] = vm.VirtualIODevice4
-	storageDevices["virtio5"] = vm.VirtualIODevice5
-	storageDevices["virtio6"] = vm.VirtualIODevice6
-	storageDevices["virtio7"] = vm.VirtualIODevice7
-	storageDevices["virtio8"] = vm.VirtualIODevice8
-	storageDevices["virtio9"] = vm.VirtualIODevice9
-	storageDevices["virtio10"] = vm.VirtualIODevice10
-	storageDevices["virtio11"] = vm.VirtualIODevice11
-	storageDevices["virtio12"] = vm.VirtualIODevice12
-	storageDevices["virtio13"] = vm.VirtualIODevice13
-	storageDevices["virtio14"] = vm.VirtualIODevice14
-	storageDevices["virtio15"] = vm.VirtualIODevice15
+	vmValue := reflect.ValueOf(vm).Elem()
+	vmType := vmValue.Type()
+
+	for i := 0; i < vmType.NumField(); i++ {
+		field := vmType.Field(i)
+
+		if field.Type != storageDeviceType {
+			continue
+		}
+
+		diskInterface := strings.Split(field.Tag.Get("json"), ",")[0]
+
+		switch diskDigitPrefix(diskInterface) {
+		case "ide", "sata", "scsi", "virtio":
+			storageDevices[diskInterface] = vmValue.Field(i).Interface().(*proxmox.CustomStorageDevice)
+		}
+	}
 
 	for k, v := range storageDevices {
 		if v != nil {
@@ -451,7 +429,8 @@ func getDiskInfo(vm *proxmox.VirtualEnvironmentVMGetResponseData, d *schema.Reso
 				}
 			}
 
-			v.Interface = &k
+			diskInterface := k
+			v.Interface = &diskInterface
 		}
 	}
 
@@ -462,33 +441,35 @@ func parseDiskSize(size *string) (int, error) {
 	var diskSize int
 	var err error
 	if size != nil {
-		if strings.HasSuffix(*size, "T") {
-			diskSize, err = strconv.Atoi(strings.TrimSuffix(*size, "T"))
-
-			if err != nil {
-				return -1, err
-			}
+		value := *size
+		unit := ""
 
-			diskSize = int(math.Ceil(float64(diskSize) * 1024))
-		} else if strings.HasSuffix(*size, "G") {
-			diskSize, err = strconv.Atoi(strings.TrimSuffix(*size, "G"))
+		if value != "" && !unicode.IsDigit(rune(value[len(value)-1])) {
+			unit = strings.ToUpper(value[len(value)-1:])
+			value = value[:len(value)-1]
+		}
 
-			if err != nil {
-				return -1, err
-			}
-		} else if strings.HasSuffix(*size, "M") {
-			diskSize, err = strconv.Atoi(strings.TrimSuffix(*size, "M"))
+		diskSize, err = strconv.Atoi(value)
 
-			if err != nil {
-				return -1, err
-			}
+		if err != nil || diskSize < 0 {
+			return -1, fmt.Errorf("Cannot parse storage size \"%s\" - expected a non-negative integer with an optional T, G, M or K suffix", *size)
+		}
 
+		switch unit {
+		case "T":
+			diskSize = diskSize * 1024
+		case "G":
+		case "M":
 			diskSize = int(math.Ceil(float64(diskSize) / 1024))
-		} else {
-			return -1, fmt.Errorf("Cannot parse storage size \"%s\"", *size)
+		case "K":
+			diskSize = int(math.Ceil(float64(diskSize) / 1024 / 1024))
+		case "":
+			diskSize = int(math.Ceil(float64(diskSize) / 1024 / 1024 / 1024))
+		default:
+			return -1, fmt.Errorf("Cannot parse storage size \"%s\" - unsupported suffix \"%s\"", *size, unit)
 		}
 	}
-	return diskSize, err
+	return diskSize, nil
 }
 
 func getCloudInitTypeValidator() schema.SchemaValidateFunc {
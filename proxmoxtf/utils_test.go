@@ -0,0 +1,225 @@
+/* This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/. */
+
+package proxmoxtf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/bpg/terraform-provider-proxmox/proxmox"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// TestCPUFlagsValidator tests the validation of CPU flags.
+func TestCPUFlagsValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{"empty list", []interface{}{}, false},
+		{"known flag", []interface{}{"+pdpe1gb"}, false},
+		{"known disabled flag", []interface{}{"-md-clear"}, false},
+		{"new flags", []interface{}{"+aes-ni", "+flush-l1d", "+hv-ipi", "-hv-reset", "+hv-stimer"}, false},
+		{"flag with dot", []interface{}{"+sse4.1"}, false},
+		{"flag with underscore", []interface{}{"-sse4_1"}, false},
+		{"flag with uppercase", []interface{}{"+Hv-Vpindex"}, false},
+		{"leading dot", []interface{}{"+.sse4"}, true},
+		{"leading underscore", []interface{}{"+_sse4"}, true},
+		{"not a list", "+aes", true},
+		{"not a string", []interface{}{1}, true},
+		{"missing sign", []interface{}{"aes"}, true},
+		{"double sign", []interface{}{"++foo"}, true},
+		{"mixed sign", []interface{}{"+-foo"}, true},
+		{"sign only", []interface{}{"+"}, true},
+		{"empty string", []interface{}{""}, true},
+		{"whitespace", []interface{}{"+aes ni"}, true},
+		{"invalid character", []interface{}{"+aes;ni"}, true},
+		{"valid and invalid", []interface{}{"+aes", "aes"}, true},
+	}
+
+	validator := getCPUFlagsValidator()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, es := validator(tt.value, "flags")
+
+			if (len(es) > 0) != tt.wantErr {
+				t.Errorf("getCPUFlagsValidator() errors = %v, wantErr %v", es, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestGetDiskInfo tests that every storage device of a virtual machine configuration is returned.
+func TestGetDiskInfo(t *testing.T) {
+	vm := &proxmox.VirtualEnvironmentVMGetResponseData{
+		IDEDevice3:        &proxmox.CustomStorageDevice{FileVolume: "local-lvm:vm-100-disk-0"},
+		SATADevice5:       &proxmox.CustomStorageDevice{FileVolume: "local-lvm:vm-100-disk-1"},
+		SCSIDevice0:       &proxmox.CustomStorageDevice{FileVolume: "local-lvm:vm-100-disk-2"},
+		SCSIDevice14:      &proxmox.CustomStorageDevice{FileVolume: "local-lvm:vm-100-disk-3"},
+		SCSIDevice30:      &proxmox.CustomStorageDevice{FileVolume: "local-lvm:vm-100-disk-4"},
+		VirtualIODevice15: &proxmox.CustomStorageDevice{FileVolume: "local-lvm:vm-100-disk-5"},
+	}
+
+	s := resourceVirtualEnvironmentVM()
+	d := schema.TestResourceDataRaw(t, s.Schema, map[string]interface{}{})
+
+	storageDevices := getDiskInfo(vm, d)
+
+	if len(storageDevices) != 4+6+31+16 {
+		t.Fatalf("Expected %d storage devices, got %d", 4+6+31+16, len(storageDevices))
+	}
+
+	expected := map[string]string{
+		"ide3":     "local-lvm:vm-100-disk-0",
+		"sata5":    "local-lvm:vm-100-disk-1",
+		"scsi0":    "local-lvm:vm-100-disk-2",
+		"scsi14":   "local-lvm:vm-100-disk-3",
+		"scsi30":   "local-lvm:vm-100-disk-4",
+		"virtio15": "local-lvm:vm-100-disk-5",
+	}
+
+	for k, v := range storageDevices {
+		fileVolume, ok := expected[k]
+
+		if !ok {
+			if v != nil {
+				t.Errorf("Unexpected storage device %s", k)
+			}
+
+			continue
+		}
+
+		if v == nil {
+			t.Errorf("Missing storage device %s", k)
+			continue
+		}
+
+		if v.FileVolume != fileVolume {
+			t.Errorf("Storage device %s has volume %s (expected: %s)", k, v.FileVolume, fileVolume)
+		}
+
+		if v.Interface == nil || *v.Interface != k {
+			t.Errorf("Storage device %s has interface %v", k, v.Interface)
+		}
+	}
+}
+
+// TestNetworkInterfaceTypeValidators tests that only the list filter accepts any_bridge.
+func TestNetworkInterfaceTypeValidators(t *testing.T) {
+	_, es := getNetworkInterfaceTypeFilterValidator()("any_bridge", "type")
+
+	if len(es) > 0 {
+		t.Errorf("Expected the filter validator to accept any_bridge, got %v", es)
+	}
+
+	_, es = getNetworkInterfaceTypeValidator()("any_bridge", "type")
+
+	if len(es) == 0 {
+		t.Errorf("Expected the interface type validator to reject any_bridge")
+	}
+
+	_, es = getNetworkInterfaceTypeValidator()("bridge", "type")
+
+	if len(es) > 0 {
+		t.Errorf("Expected the interface type validator to accept bridge, got %v", es)
+	}
+}
+
+// TestParseDiskSize tests the conversion of storage sizes to gigabytes.
+func TestParseDiskSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    *string
+		want    int
+		wantErr bool
+	}{
+		{"nil size", nil, 0, false},
+		{"terabytes", strPtr("2T"), 2048, false},
+		{"terabytes lowercase", strPtr("2t"), 2048, false},
+		{"gigabytes", strPtr("8G"), 8, false},
+		{"gigabytes lowercase", strPtr("8g"), 8, false},
+		{"megabytes", strPtr("8192M"), 8, false},
+		{"megabytes lowercase", strPtr("8192m"), 8, false},
+		{"megabytes rounded up to 1", strPtr("512M"), 1, false},
+		{"megabytes exact gigabyte", strPtr("1024M"), 1, false},
+		{"megabytes rounded up to 2", strPtr("1536M"), 2, false},
+		{"kilobytes", strPtr("1048576K"), 1, false},
+		{"kilobytes lowercase rounded up", strPtr("1k"), 1, false},
+		{"kilobytes rounded up to 2", strPtr("1048577K"), 2, false},
+		{"bytes", strPtr("1073741824"), 1, false},
+		{"bytes rounded up to 1", strPtr("1"), 1, false},
+		{"bytes rounded up to 2", strPtr("1073741825"), 2, false},
+		{"zero bytes", strPtr("0"), 0, false},
+		{"empty", strPtr(""), -1, true},
+		{"negative", strPtr("-8G"), -1, true},
+		{"negative bytes", strPtr("-1"), -1, true},
+		{"not a number", strPtr("abcG"), -1, true},
+		{"unknown suffix", strPtr("8P"), -1, true},
+		{"suffix only", strPtr("G"), -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDiskSize(tt.size)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDiskSize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("parseDiskSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+// testVirtualEnvironmentClient creates a client which sends its requests to a local test server.
+func testVirtualEnvironmentClient(t *testing.T, handler http.HandlerFunc) (*proxmox.VirtualEnvironmentClient, func()) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		handler(w, r)
+	}))
+
+	veClient, err := proxmox.NewVirtualEnvironmentClient(server.URL, "root@pam", "", "dummy", "test", "secret", "", true)
+
+	if err != nil {
+		server.Close()
+		t.Fatalf("Cannot create client: %s", err.Error())
+	}
+
+	return veClient, server.Close
+}
+
+// testResourceDataWithState creates resource data which holds the given state and the changes to the given configuration.
+func testResourceDataWithState(t *testing.T, r *schema.Resource, id string, state map[string]interface{}, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	previous := schema.TestResourceDataRaw(t, r.Schema, state)
+	previous.SetId(id)
+
+	instanceState := previous.State()
+	sm := schema.InternalMap(r.Schema)
+	diff, err := sm.Diff(instanceState, terraform.NewResourceConfigRaw(config), r.CustomizeDiff, nil, true)
+
+	if err != nil {
+		t.Fatalf("Cannot compute the diff: %s", err.Error())
+	}
+
+	d, err := sm.Data(instanceState, diff)
+
+	if err != nil {
+		t.Fatalf("Cannot create the resource data: %s", err.Error())
+	}
+
+	return d
+}